@@ -0,0 +1,216 @@
+package datasources
+
+import (
+	"database/sql"
+	"fmt"
+	"strings"
+
+	"github.com/chanzuckerberg/terraform-provider-snowflake/pkg/snowflake"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/pkg/errors"
+)
+
+var udfSchema = map[string]*schema.Schema{
+	"database": {
+		Type:        schema.TypeString,
+		Required:    true,
+		Description: "The database in which the function exists.",
+	},
+	"schema": {
+		Type:        schema.TypeString,
+		Required:    true,
+		Description: "The schema in which the function exists.",
+	},
+	"name": {
+		Type:        schema.TypeString,
+		Required:    true,
+		Description: "The name of the function.",
+	},
+	"argument_types": {
+		Type:        schema.TypeList,
+		Elem:        &schema.Schema{Type: schema.TypeString},
+		Optional:    true,
+		Description: "The argument types identifying the overload to read, spelled the way SHOW FUNCTIONS reports them (e.g. NUMBER, VARCHAR rather than INT, STRING or NUMBER(38,0)). Required when the function is overloaded.",
+	},
+	"zero_arguments": {
+		Type:          schema.TypeBool,
+		Optional:      true,
+		Default:       false,
+		ConflictsWith: []string{"argument_types"},
+		Description:   "Select the overload that takes no arguments. Needed when the function is overloaded, since an empty argument_types cannot be told apart from an unset one.",
+	},
+	"arguments": {
+		Type:        schema.TypeList,
+		Computed:    true,
+		Description: "The arguments of the function, in declaration order.",
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"name": {
+					Type:        schema.TypeString,
+					Computed:    true,
+					Description: "The argument name",
+				},
+				"type": {
+					Type:        schema.TypeString,
+					Computed:    true,
+					Description: "The argument type",
+				},
+			},
+		},
+	},
+	"return_type": {
+		Type:        schema.TypeString,
+		Computed:    true,
+		Description: "The return type of the function.",
+	},
+	"language": {
+		Type:        schema.TypeString,
+		Computed:    true,
+		Description: "The language the function is written in.",
+	},
+	"is_secure": {
+		Type:        schema.TypeBool,
+		Computed:    true,
+		Description: "Whether the function is secure.",
+	},
+	"comment": {
+		Type:        schema.TypeString,
+		Computed:    true,
+		Description: "The comment on the function.",
+	},
+	"body": {
+		Type:        schema.TypeString,
+		Computed:    true,
+		Description: "The body of the function. Empty for functions that do not report one, such as external functions.",
+	},
+}
+
+func Udf() *schema.Resource {
+	return &schema.Resource{
+		Read:   ReadUdf,
+		Schema: udfSchema,
+	}
+}
+
+// ReadUdf implements schema.ReadFunc
+func ReadUdf(d *schema.ResourceData, meta interface{}) error {
+	db := meta.(*sql.DB)
+	database := d.Get("database").(string)
+	schemaName := d.Get("schema").(string)
+	name := d.Get("name").(string)
+
+	argumentTypes := []string{}
+	for _, t := range d.Get("argument_types").([]interface{}) {
+		argumentTypes = append(argumentTypes, strings.ToUpper(strings.TrimSpace(t.(string))))
+	}
+	_, filterByArguments := d.GetOk("argument_types")
+	if d.Get("zero_arguments").(bool) {
+		filterByArguments = true
+	}
+
+	builder := snowflake.Udf(name, database, schemaName)
+	rows, err := snowflake.Query(db, builder.Show())
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+
+	udfs, err := snowflake.ScanUdfs(rows)
+	if err != nil {
+		return errors.Wrapf(err, "unable to scan functions for %v", builder.QualifiedName())
+	}
+
+	matches := []*snowflake.UdfStruct{}
+	for _, u := range udfs {
+		// SHOW FUNCTIONS LIKE is case-insensitive and treats _ and % as wildcards
+		if u.Name.String != name {
+			continue
+		}
+		if filterByArguments && strings.Join(u.ArgumentTypes(), ", ") != strings.Join(argumentTypes, ", ") {
+			continue
+		}
+		matches = append(matches, u)
+	}
+
+	if len(matches) == 0 {
+		lookup := builder.QualifiedName()
+		if filterByArguments {
+			lookup = fmt.Sprintf("%v(%v)", lookup, strings.Join(argumentTypes, ", "))
+		}
+
+		signatures, nearMisses := []string{}, []string{}
+		for _, u := range udfs {
+			signature := fmt.Sprintf("%v(%v)", u.Name.String, strings.Join(u.ArgumentTypes(), ", "))
+			if u.Name.String == name {
+				signatures = append(signatures, signature)
+			} else {
+				nearMisses = append(nearMisses, signature)
+			}
+		}
+
+		switch {
+		case len(signatures) > 0:
+			return fmt.Errorf("function %v not found, available signatures: %v", lookup, strings.Join(signatures, "; "))
+		case len(nearMisses) > 0:
+			return fmt.Errorf("function %v not found, did you mean: %v", lookup, strings.Join(nearMisses, "; "))
+		default:
+			return fmt.Errorf("function %v not found", lookup)
+		}
+	}
+	if len(matches) > 1 {
+		return fmt.Errorf("function %v has %d overloads, set argument_types or zero_arguments to select one", builder.QualifiedName(), len(matches))
+	}
+	u := matches[0]
+
+	builder = snowflake.Udf(u.Name.String, database, schemaName).WithArgumentTypes(u.ArgumentTypes())
+	descRows, err := snowflake.Query(db, builder.Describe())
+	if err != nil {
+		return err
+	}
+	defer descRows.Close()
+
+	desc, err := snowflake.ScanUdfDescription(descRows)
+	if err != nil {
+		return errors.Wrapf(err, "unable to describe function %v", builder.QualifiedName())
+	}
+
+	d.SetId(fmt.Sprintf("%v|%v|%v(%v)", database, schemaName, u.Name.String, strings.Join(u.ArgumentTypes(), ", ")))
+
+	args, err := snowflake.ParseSignature(desc.Signature.String)
+	if err != nil {
+		return err
+	}
+	arguments := []map[string]interface{}{}
+	for _, arg := range args {
+		arguments = append(arguments, map[string]interface{}{
+			"name": arg.Name,
+			"type": arg.Type,
+		})
+	}
+	err = d.Set("arguments", arguments)
+	if err != nil {
+		return err
+	}
+
+	err = d.Set("return_type", desc.Returns.String)
+	if err != nil {
+		return err
+	}
+
+	err = d.Set("language", u.Language.String)
+	if err != nil {
+		return err
+	}
+
+	err = d.Set("is_secure", u.IsSecure.String == "Y")
+	if err != nil {
+		return err
+	}
+
+	err = d.Set("comment", u.Comment.String)
+	if err != nil {
+		return err
+	}
+
+	return d.Set("body", desc.Body.String)
+}
@@ -0,0 +1,192 @@
+package datasources_test
+
+import (
+	"database/sql"
+	"testing"
+
+	sqlmock "github.com/DATA-DOG/go-sqlmock"
+	"github.com/chanzuckerberg/terraform-provider-snowflake/pkg/datasources"
+	. "github.com/chanzuckerberg/terraform-provider-snowflake/pkg/testhelpers"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/require"
+)
+
+func udf(t *testing.T, params map[string]interface{}) *schema.ResourceData {
+	r := require.New(t)
+	d := schema.TestResourceDataRaw(t, datasources.Udf().Schema, params)
+	r.NotNil(d)
+	return d
+}
+
+func expectShowUdfs(mock sqlmock.Sqlmock, like string) {
+	rows := sqlmock.NewRows([]string{
+		"created_on", "name", "schema_name", "is_builtin", "is_aggregate", "is_ansi", "min_num_arguments", "max_num_arguments", "arguments", "description", "catalog_name", "is_table_function", "valid_for_clustering", "is_secure", "is_external_function", "language",
+	}).AddRow(
+		"2019-05-19 16:55:36.530 -0700", "TEST_UDF", "test_schema", "N", "N", "N", 1, 1, "TEST_UDF(NUMBER) RETURN NUMBER", "user-defined function", "test_db", "N", "N", "N", "N", "SQL",
+	).AddRow(
+		"2019-05-19 16:55:36.530 -0700", "TEST_UDF", "test_schema", "N", "N", "N", 2, 2, "TEST_UDF(NUMBER, VARCHAR) RETURN VARCHAR", "great comment", "test_db", "N", "N", "Y", "N", "JAVASCRIPT",
+	).AddRow(
+		// matched by the _ wildcard in LIKE 'TEST_UDF'
+		"2019-05-19 16:55:36.530 -0700", "TESTXUDF", "test_schema", "N", "N", "N", 1, 1, "TESTXUDF(NUMBER) RETURN NUMBER", "lookalike", "test_db", "N", "N", "N", "N", "SQL",
+	)
+	mock.ExpectQuery(`^SHOW FUNCTIONS LIKE '` + like + `' IN SCHEMA "test_db"."test_schema"$`).WillReturnRows(rows)
+}
+
+func TestUdfRead(t *testing.T) {
+	r := require.New(t)
+
+	d := udf(t, map[string]interface{}{
+		"database":       "test_db",
+		"schema":         "test_schema",
+		"name":           "TEST_UDF",
+		"argument_types": []interface{}{"number", "VARCHAR"},
+	})
+
+	WithMockDb(t, func(db *sql.DB, mock sqlmock.Sqlmock) {
+		expectShowUdfs(mock, "TEST_UDF")
+		descRows := sqlmock.NewRows([]string{"property", "value"}).
+			AddRow("signature", "(A NUMBER, B VARCHAR)").
+			AddRow("returns", "VARCHAR(16777216)").
+			AddRow("language", "JAVASCRIPT").
+			AddRow("body", "return B;")
+		mock.ExpectQuery(`^DESCRIBE FUNCTION "test_db"."test_schema"."TEST_UDF"\(NUMBER, VARCHAR\)$`).WillReturnRows(descRows)
+
+		err := datasources.ReadUdf(d, db)
+		r.NoError(err)
+		r.Equal("test_db|test_schema|TEST_UDF(NUMBER, VARCHAR)", d.Id())
+		r.Equal([]interface{}{
+			map[string]interface{}{"name": "A", "type": "NUMBER"},
+			map[string]interface{}{"name": "B", "type": "VARCHAR"},
+		}, d.Get("arguments").([]interface{}))
+		r.Equal("VARCHAR(16777216)", d.Get("return_type").(string))
+		r.Equal("JAVASCRIPT", d.Get("language").(string))
+		r.Equal(true, d.Get("is_secure").(bool))
+		r.Equal("great comment", d.Get("comment").(string))
+		r.Equal("return B;", d.Get("body").(string))
+	})
+}
+
+func TestUdfReadAmbiguousOverload(t *testing.T) {
+	r := require.New(t)
+
+	d := udf(t, map[string]interface{}{
+		"database": "test_db",
+		"schema":   "test_schema",
+		"name":     "TEST_UDF",
+	})
+
+	WithMockDb(t, func(db *sql.DB, mock sqlmock.Sqlmock) {
+		expectShowUdfs(mock, "TEST_UDF")
+		err := datasources.ReadUdf(d, db)
+		r.EqualError(err, `function "test_db"."test_schema"."TEST_UDF" has 2 overloads, set argument_types or zero_arguments to select one`)
+	})
+}
+
+func TestUdfReadNotFound(t *testing.T) {
+	r := require.New(t)
+
+	d := udf(t, map[string]interface{}{
+		"database":       "test_db",
+		"schema":         "test_schema",
+		"name":           "TEST_UDF",
+		"argument_types": []interface{}{"INT"},
+	})
+
+	WithMockDb(t, func(db *sql.DB, mock sqlmock.Sqlmock) {
+		expectShowUdfs(mock, "TEST_UDF")
+		err := datasources.ReadUdf(d, db)
+		r.EqualError(err, `function "test_db"."test_schema"."TEST_UDF"(INT) not found, available signatures: TEST_UDF(NUMBER); TEST_UDF(NUMBER, VARCHAR)`)
+	})
+}
+
+func TestUdfReadIgnoresWildcardLookalike(t *testing.T) {
+	r := require.New(t)
+
+	d := udf(t, map[string]interface{}{
+		"database":       "test_db",
+		"schema":         "test_schema",
+		"name":           "TEST_UDF",
+		"argument_types": []interface{}{"NUMBER"},
+	})
+
+	WithMockDb(t, func(db *sql.DB, mock sqlmock.Sqlmock) {
+		expectShowUdfs(mock, "TEST_UDF")
+		descRows := sqlmock.NewRows([]string{"property", "value"}).
+			AddRow("signature", "(A NUMBER)").
+			AddRow("returns", "NUMBER(38,0)").
+			AddRow("language", "SQL").
+			AddRow("body", "A + 1")
+		mock.ExpectQuery(`^DESCRIBE FUNCTION "test_db"."test_schema"."TEST_UDF"\(NUMBER\)$`).WillReturnRows(descRows)
+
+		err := datasources.ReadUdf(d, db)
+		r.NoError(err)
+		r.Equal("test_db|test_schema|TEST_UDF(NUMBER)", d.Id())
+		r.Equal("user-defined function", d.Get("comment").(string))
+	})
+}
+
+func TestUdfReadCaseMismatch(t *testing.T) {
+	r := require.New(t)
+
+	d := udf(t, map[string]interface{}{
+		"database":       "test_db",
+		"schema":         "test_schema",
+		"name":           "test_udf",
+		"argument_types": []interface{}{"NUMBER"},
+	})
+
+	WithMockDb(t, func(db *sql.DB, mock sqlmock.Sqlmock) {
+		// SHOW matches case-insensitively, but "test_udf" is a different quoted identifier than TEST_UDF
+		expectShowUdfs(mock, "test_udf")
+		err := datasources.ReadUdf(d, db)
+		r.EqualError(err, `function "test_db"."test_schema"."test_udf"(NUMBER) not found, did you mean: TEST_UDF(NUMBER); TEST_UDF(NUMBER, VARCHAR); TESTXUDF(NUMBER)`)
+	})
+
+	d = udf(t, map[string]interface{}{
+		"database": "test_db",
+		"schema":   "test_schema",
+		"name":     "test_udf",
+	})
+
+	WithMockDb(t, func(db *sql.DB, mock sqlmock.Sqlmock) {
+		expectShowUdfs(mock, "test_udf")
+		err := datasources.ReadUdf(d, db)
+		r.EqualError(err, `function "test_db"."test_schema"."test_udf" not found, did you mean: TEST_UDF(NUMBER); TEST_UDF(NUMBER, VARCHAR); TESTXUDF(NUMBER)`)
+	})
+}
+
+func TestUdfReadZeroArguments(t *testing.T) {
+	r := require.New(t)
+
+	d := udf(t, map[string]interface{}{
+		"database":       "test_db",
+		"schema":         "test_schema",
+		"name":           "ZERO_UDF",
+		"zero_arguments": true,
+	})
+
+	WithMockDb(t, func(db *sql.DB, mock sqlmock.Sqlmock) {
+		rows := sqlmock.NewRows([]string{
+			"created_on", "name", "schema_name", "is_builtin", "is_aggregate", "is_ansi", "min_num_arguments", "max_num_arguments", "arguments", "description", "catalog_name", "is_table_function", "valid_for_clustering", "is_secure", "is_external_function", "language",
+		}).AddRow(
+			"2019-05-19 16:55:36.530 -0700", "ZERO_UDF", "test_schema", "N", "N", "N", 1, 1, "ZERO_UDF(NUMBER) RETURN NUMBER", "one argument", "test_db", "N", "N", "N", "N", "SQL",
+		).AddRow(
+			"2019-05-19 16:55:36.530 -0700", "ZERO_UDF", "test_schema", "N", "N", "N", 0, 0, "ZERO_UDF() RETURN NUMBER", "no arguments", "test_db", "N", "N", "N", "N", "SQL",
+		)
+		mock.ExpectQuery(`^SHOW FUNCTIONS LIKE 'ZERO_UDF' IN SCHEMA "test_db"."test_schema"$`).WillReturnRows(rows)
+
+		descRows := sqlmock.NewRows([]string{"property", "value"}).
+			AddRow("signature", "()").
+			AddRow("returns", "NUMBER(38,0)").
+			AddRow("language", "SQL").
+			AddRow("body", "1")
+		mock.ExpectQuery(`^DESCRIBE FUNCTION "test_db"."test_schema"."ZERO_UDF"\(\)$`).WillReturnRows(descRows)
+
+		err := datasources.ReadUdf(d, db)
+		r.NoError(err)
+		r.Equal("test_db|test_schema|ZERO_UDF()", d.Id())
+		r.Equal("no arguments", d.Get("comment").(string))
+		r.Len(d.Get("arguments").([]interface{}), 0)
+		r.Equal("1", d.Get("body").(string))
+	})
+}
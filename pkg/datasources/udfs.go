@@ -0,0 +1,104 @@
+package datasources
+
+import (
+	"database/sql"
+	"fmt"
+
+	"github.com/chanzuckerberg/terraform-provider-snowflake/pkg/snowflake"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/pkg/errors"
+)
+
+var udfsSchema = map[string]*schema.Schema{
+	"database": {
+		Type:        schema.TypeString,
+		Required:    true,
+		Description: "The database from which to return the functions.",
+	},
+	"schema": {
+		Type:        schema.TypeString,
+		Required:    true,
+		Description: "The schema from which to return the functions.",
+	},
+	"include_builtins": {
+		Type:        schema.TypeBool,
+		Optional:    true,
+		Default:     false,
+		Description: "Whether to include Snowflake built-in functions in the result.",
+	},
+	"udfs": {
+		Type:        schema.TypeList,
+		Computed:    true,
+		Description: "The functions in the schema.",
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"name": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"argument_types": {
+					Type:     schema.TypeList,
+					Elem:     &schema.Schema{Type: schema.TypeString},
+					Computed: true,
+				},
+				"language": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"is_secure": {
+					Type:     schema.TypeBool,
+					Computed: true,
+				},
+				"comment": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+			},
+		},
+	},
+}
+
+func Udfs() *schema.Resource {
+	return &schema.Resource{
+		Read:   ReadUdfs,
+		Schema: udfsSchema,
+	}
+}
+
+// ReadUdfs implements schema.ReadFunc
+func ReadUdfs(d *schema.ResourceData, meta interface{}) error {
+	db := meta.(*sql.DB)
+	database := d.Get("database").(string)
+	schemaName := d.Get("schema").(string)
+	includeBuiltins := d.Get("include_builtins").(bool)
+
+	stmt := snowflake.ShowUdfsInSchema(database, schemaName)
+	rows, err := snowflake.Query(db, stmt)
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+
+	udfs, err := snowflake.ScanUdfs(rows)
+	if err != nil {
+		return errors.Wrapf(err, "unable to scan row for %s", stmt)
+	}
+
+	list := []map[string]interface{}{}
+	for _, u := range udfs {
+		if u.IsBuiltin.String == "Y" && !includeBuiltins {
+			continue
+		}
+
+		list = append(list, map[string]interface{}{
+			"name":           u.Name.String,
+			"argument_types": u.ArgumentTypes(),
+			"language":       u.Language.String,
+			"is_secure":      u.IsSecure.String == "Y",
+			"comment":        u.Comment.String,
+		})
+	}
+
+	d.SetId(fmt.Sprintf("%v|%v", database, schemaName))
+	return d.Set("udfs", list)
+}
@@ -0,0 +1,72 @@
+package datasources_test
+
+import (
+	"database/sql"
+	"testing"
+
+	sqlmock "github.com/DATA-DOG/go-sqlmock"
+	"github.com/chanzuckerberg/terraform-provider-snowflake/pkg/datasources"
+	. "github.com/chanzuckerberg/terraform-provider-snowflake/pkg/testhelpers"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/require"
+)
+
+func udfs(t *testing.T, params map[string]interface{}) *schema.ResourceData {
+	r := require.New(t)
+	d := schema.TestResourceDataRaw(t, datasources.Udfs().Schema, params)
+	r.NotNil(d)
+	return d
+}
+
+func expectListUdfs(mock sqlmock.Sqlmock) {
+	rows := sqlmock.NewRows([]string{
+		"created_on", "name", "schema_name", "is_builtin", "is_aggregate", "is_ansi", "min_num_arguments", "max_num_arguments", "arguments", "description", "catalog_name", "is_table_function", "valid_for_clustering", "is_secure", "is_external_function", "language",
+	}).AddRow(
+		"2019-05-19 16:55:36.530 -0700", "ABS", "", "Y", "N", "Y", 1, 1, "ABS(NUMBER) RETURN NUMBER", "Returns the absolute value", "", "N", "Y", "N", "N", "SQL",
+	).AddRow(
+		"2019-05-19 16:55:36.530 -0700", "TEST_UDF", "test_schema", "N", "N", "N", 2, 2, "TEST_UDF(NUMBER, VARCHAR) RETURN VARCHAR", "great comment", "test_db", "N", "N", "Y", "N", "JAVASCRIPT",
+	)
+	mock.ExpectQuery(`^SHOW FUNCTIONS IN SCHEMA "test_db"."test_schema"$`).WillReturnRows(rows)
+}
+
+func TestUdfsRead(t *testing.T) {
+	r := require.New(t)
+
+	d := udfs(t, map[string]interface{}{
+		"database": "test_db",
+		"schema":   "test_schema",
+	})
+
+	WithMockDb(t, func(db *sql.DB, mock sqlmock.Sqlmock) {
+		expectListUdfs(mock)
+		err := datasources.ReadUdfs(d, db)
+		r.NoError(err)
+		r.Equal("test_db|test_schema", d.Id())
+
+		list := d.Get("udfs").([]interface{})
+		r.Len(list, 1)
+		u := list[0].(map[string]interface{})
+		r.Equal("TEST_UDF", u["name"])
+		r.Equal([]interface{}{"NUMBER", "VARCHAR"}, u["argument_types"])
+		r.Equal("JAVASCRIPT", u["language"])
+		r.Equal(true, u["is_secure"])
+		r.Equal("great comment", u["comment"])
+	})
+}
+
+func TestUdfsReadIncludeBuiltins(t *testing.T) {
+	r := require.New(t)
+
+	d := udfs(t, map[string]interface{}{
+		"database":         "test_db",
+		"schema":           "test_schema",
+		"include_builtins": true,
+	})
+
+	WithMockDb(t, func(db *sql.DB, mock sqlmock.Sqlmock) {
+		expectListUdfs(mock)
+		err := datasources.ReadUdfs(d, db)
+		r.NoError(err)
+		r.Len(d.Get("udfs").([]interface{}), 2)
+	})
+}
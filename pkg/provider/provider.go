@@ -77,6 +77,8 @@ func Provider() *schema.Provider {
 		ResourcesMap: getResources(),
 		DataSourcesMap: map[string]*schema.Resource{
 			"snowflake_system_get_aws_sns_iam_policy": datasources.SystemGetAWSSNSIAMPolicy(),
+			"snowflake_udf":  datasources.Udf(),
+			"snowflake_udfs": datasources.Udfs(),
 		},
 		ConfigureFunc: ConfigureProvider,
 	}
@@ -10,6 +10,12 @@ func EscapeString(in string) string {
 	return out
 }
 
+// EscapeIdentifier doubles the " character so a name can't break out of a quoted identifier.
+// Backslashes have no special meaning inside quoted identifiers and are left alone.
+func EscapeIdentifier(in string) string {
+	return strings.Replace(in, `"`, `""`, -1)
+}
+
 // UnescapeString reverses EscapeString
 func UnescapeString(in string) string {
 	out := strings.Replace(in, `\\`, `\`, -1)
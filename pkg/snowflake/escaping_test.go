@@ -13,3 +13,10 @@ func TestEscapeString(t *testing.T) {
 	r.Equal(`\'`, snowflake.EscapeString(`'`))
 	r.Equal(`\\\'`, snowflake.EscapeString(`\'`))
 }
+
+func TestEscapeIdentifier(t *testing.T) {
+	r := require.New(t)
+
+	r.Equal(`a""b`, snowflake.EscapeIdentifier(`a"b`))
+	r.Equal(`a\b`, snowflake.EscapeIdentifier(`a\b`))
+}
@@ -0,0 +1,282 @@
+package snowflake
+
+import (
+	"database/sql"
+	"fmt"
+	"strings"
+	"unicode"
+
+	"github.com/jmoiron/sqlx"
+	"github.com/pkg/errors"
+)
+
+// UdfBuilder abstracts the creation of SQL queries for a Snowflake user-defined function
+type UdfBuilder struct {
+	name          string
+	db            string
+	schema        string
+	argumentTypes []string
+}
+
+// QualifiedName prepends the db and schema and escapes everything nicely
+func (ub *UdfBuilder) QualifiedName() string {
+	return fmt.Sprintf(`"%v"."%v"."%v"`, EscapeIdentifier(ub.db), EscapeIdentifier(ub.schema), EscapeIdentifier(ub.name))
+}
+
+// WithArgumentTypes adds the argument types identifying a single overload to the UdfBuilder
+func (ub *UdfBuilder) WithArgumentTypes(t []string) *UdfBuilder {
+	ub.argumentTypes = t
+	return ub
+}
+
+// Udf returns a pointer to a Builder that abstracts the read operations for a user-defined function.
+//
+// Supported operations are:
+//   - SHOW FUNCTIONS
+//   - DESCRIBE FUNCTION
+//
+// [Snowflake Reference](https://docs.snowflake.com/en/sql-reference/sql/show-functions.html)
+func Udf(name, db, schema string) *UdfBuilder {
+	return &UdfBuilder{
+		name:   name,
+		db:     db,
+		schema: schema,
+	}
+}
+
+// Show returns the SQL query that will show all overloads of a function.
+func (ub *UdfBuilder) Show() string {
+	return fmt.Sprintf(`SHOW FUNCTIONS LIKE '%v' IN SCHEMA "%v"."%v"`, EscapeString(ub.name), EscapeIdentifier(ub.db), EscapeIdentifier(ub.schema))
+}
+
+// ShowUdfsInSchema returns the SQL query that will show every function in a schema.
+func ShowUdfsInSchema(db, schema string) string {
+	return fmt.Sprintf(`SHOW FUNCTIONS IN SCHEMA "%v"."%v"`, EscapeIdentifier(db), EscapeIdentifier(schema))
+}
+
+// Describe returns the SQL query that will describe the overload matching the argument types.
+func (ub *UdfBuilder) Describe() string {
+	return fmt.Sprintf(`DESCRIBE FUNCTION %v(%v)`, ub.QualifiedName(), strings.Join(ub.argumentTypes, ", "))
+}
+
+type UdfStruct struct {
+	CreatedOn          sql.NullString `db:"created_on"`
+	Name               sql.NullString `db:"name"`
+	SchemaName         sql.NullString `db:"schema_name"`
+	DatabaseName       sql.NullString `db:"catalog_name"`
+	IsBuiltin          sql.NullString `db:"is_builtin"`
+	IsAggregate        sql.NullString `db:"is_aggregate"`
+	Arguments          sql.NullString `db:"arguments"`
+	Comment            sql.NullString `db:"description"`
+	IsTableFunction    sql.NullString `db:"is_table_function"`
+	IsSecure           sql.NullString `db:"is_secure"`
+	IsExternalFunction sql.NullString `db:"is_external_function"`
+	Language           sql.NullString `db:"language"`
+}
+
+// ArgumentTypes parses the argument types out of the arguments column, which
+// Snowflake formats as `NAME(TYPE, TYPE) RETURN TYPE`.
+func (u *UdfStruct) ArgumentTypes() []string {
+	args := u.Arguments.String
+	// the name itself may contain ( when quoted, so skip past it rather than searching for the first paren
+	start := len(u.Name.String)
+	if !strings.HasPrefix(args, u.Name.String+"(") {
+		start = strings.Index(args, "(")
+	}
+	end := strings.LastIndex(args, ") RETURN ")
+	if start == -1 || end < start {
+		return []string{}
+	}
+
+	// split on top-level commas only so parameterized types like NUMBER(38,0) stay whole
+	parts, err := splitTopLevel(args[start+1 : end])
+	if err != nil {
+		return []string{}
+	}
+
+	types := []string{}
+	for _, t := range parts {
+		if t != "" {
+			types = append(types, t)
+		}
+	}
+	return types
+}
+
+// ScanUdfs takes the rows of SHOW FUNCTIONS and converts them to a list of UdfStruct pointers
+func ScanUdfs(rows *sqlx.Rows) ([]*UdfStruct, error) {
+	udfs := []*UdfStruct{}
+
+	for rows.Next() {
+		u := &UdfStruct{}
+		err := rows.StructScan(u)
+		if err != nil {
+			return nil, err
+		}
+		udfs = append(udfs, u)
+	}
+	return udfs, rows.Err()
+}
+
+type descUdfRow struct {
+	Property string         `db:"property"`
+	Value    sql.NullString `db:"value"`
+}
+
+type udfDescription struct {
+	Signature sql.NullString
+	Returns   sql.NullString
+	Body      sql.NullString
+}
+
+// ScanUdfDescription takes the property/value rows of DESCRIBE FUNCTION and
+// converts them to a udfDescription pointer. Body is left null for built-in
+// and external functions, which do not report one.
+func ScanUdfDescription(rows *sqlx.Rows) (*udfDescription, error) {
+	desc := &udfDescription{}
+
+	for rows.Next() {
+		row := &descUdfRow{}
+		if err := rows.StructScan(row); err != nil {
+			return nil, err
+		}
+
+		switch row.Property {
+		case "signature":
+			desc.Signature = row.Value
+		case "returns":
+			desc.Returns = row.Value
+		case "body":
+			desc.Body = row.Value
+		}
+	}
+	return desc, rows.Err()
+}
+
+// Argument is a single argument of a function signature
+type Argument struct {
+	Name    string
+	Type    string
+	Default string
+}
+
+// Arguments is the ordered list of arguments of a function signature
+type Arguments []Argument
+
+// ParseSignature parses a signature as DESCRIBE FUNCTION reports it, e.g.
+// `(A NUMBER(10,2), "b" VARCHAR DEFAULT 'x')`, into its arguments. Quoted
+// names are unescaped. A leading TABLE, as in the returns row of a table
+// function, is accepted and its column list parsed the same way.
+func ParseSignature(signature string) (Arguments, error) {
+	s := strings.TrimSpace(signature)
+	if len(s) >= 5 && strings.EqualFold(s[:5], "TABLE") {
+		s = strings.TrimSpace(s[5:])
+	}
+	if !strings.HasPrefix(s, "(") || !strings.HasSuffix(s, ")") {
+		return nil, fmt.Errorf("signature %q must be wrapped in parentheses", signature)
+	}
+
+	parts, err := splitTopLevel(s[1 : len(s)-1])
+	if err != nil {
+		return nil, errors.Wrapf(err, "unable to parse signature %q", signature)
+	}
+
+	args := Arguments{}
+	for _, part := range parts {
+		arg, err := parseArgument(part)
+		if err != nil {
+			return nil, errors.Wrapf(err, "unable to parse signature %q", signature)
+		}
+		args = append(args, arg)
+	}
+	return args, nil
+}
+
+// parseArgument parses a single `NAME TYPE [DEFAULT expr]` entry of a signature
+func parseArgument(part string) (Argument, error) {
+	arg := Argument{}
+	var rest string
+
+	if strings.HasPrefix(part, `"`) {
+		end := -1
+		for i := 1; i < len(part); i++ {
+			if part[i] != '"' {
+				continue
+			}
+			// "" is an escaped quote inside a quoted identifier
+			if i+1 < len(part) && part[i+1] == '"' {
+				i++
+				continue
+			}
+			end = i
+			break
+		}
+		if end == -1 {
+			return arg, fmt.Errorf("unterminated quoted name in %q", part)
+		}
+		arg.Name = strings.Replace(part[1:end], `""`, `"`, -1)
+		rest = part[end+1:]
+	} else {
+		i := strings.IndexFunc(part, unicode.IsSpace)
+		if i == -1 {
+			return arg, fmt.Errorf("missing type in %q", part)
+		}
+		arg.Name, rest = part[:i], part[i:]
+	}
+
+	rest = strings.TrimSpace(rest)
+	if i := strings.Index(strings.ToUpper(rest), " DEFAULT "); i != -1 {
+		arg.Default = strings.TrimSpace(rest[i+len(" DEFAULT "):])
+		rest = strings.TrimSpace(rest[:i])
+	}
+	if rest == "" {
+		return arg, fmt.Errorf("missing type in %q", part)
+	}
+	arg.Type = rest
+	return arg, nil
+}
+
+// splitTopLevel splits s on commas that are outside parentheses, quoted
+// identifiers and string literals, trimming each part. A blank s yields no parts.
+func splitTopLevel(s string) ([]string, error) {
+	if strings.TrimSpace(s) == "" {
+		return []string{}, nil
+	}
+
+	parts := []string{}
+	depth, last := 0, 0
+	inIdentifier, inLiteral := false, false
+	for i := 0; i < len(s); i++ {
+		c := s[i]
+		switch {
+		case inLiteral:
+			if c == '\\' {
+				i++
+			} else if c == '\'' {
+				inLiteral = false
+			}
+		case inIdentifier:
+			if c == '"' {
+				inIdentifier = false
+			}
+		case c == '\'':
+			inLiteral = true
+		case c == '"':
+			inIdentifier = true
+		case c == '(':
+			depth++
+		case c == ')':
+			depth--
+			if depth < 0 {
+				return nil, fmt.Errorf("unbalanced parentheses in %q", s)
+			}
+		case c == ',' && depth == 0:
+			parts = append(parts, strings.TrimSpace(s[last:i]))
+			last = i + 1
+		}
+	}
+	if depth != 0 || inIdentifier || inLiteral {
+		return nil, fmt.Errorf("unbalanced parentheses or quotes in %q", s)
+	}
+	return append(parts, strings.TrimSpace(s[last:])), nil
+}
@@ -0,0 +1,130 @@
+package snowflake
+
+import (
+	"testing"
+
+	sqlmock "github.com/DATA-DOG/go-sqlmock"
+	"github.com/jmoiron/sqlx"
+	"github.com/stretchr/testify/require"
+)
+
+func TestUdfShow(t *testing.T) {
+	r := require.New(t)
+	u := Udf("test_udf", "test_db", "test_schema")
+	r.Equal(u.QualifiedName(), `"test_db"."test_schema"."test_udf"`)
+	r.Equal(u.Show(), `SHOW FUNCTIONS LIKE 'test_udf' IN SCHEMA "test_db"."test_schema"`)
+
+	u = Udf("it's", "test_db", "test_schema")
+	r.Equal(u.Show(), `SHOW FUNCTIONS LIKE 'it\'s' IN SCHEMA "test_db"."test_schema"`)
+
+	u = Udf(`test"udf`, `test"db`, `test\schema`)
+	r.Equal(u.QualifiedName(), `"test""db"."test\schema"."test""udf"`)
+	r.Equal(u.Show(), `SHOW FUNCTIONS LIKE 'test"udf' IN SCHEMA "test""db"."test\schema"`)
+}
+
+func TestShowUdfsInSchema(t *testing.T) {
+	r := require.New(t)
+	r.Equal(ShowUdfsInSchema("test_db", "test_schema"), `SHOW FUNCTIONS IN SCHEMA "test_db"."test_schema"`)
+	r.Equal(ShowUdfsInSchema(`test"db`, "test_schema"), `SHOW FUNCTIONS IN SCHEMA "test""db"."test_schema"`)
+}
+
+func TestUdfDescribe(t *testing.T) {
+	r := require.New(t)
+	u := Udf("test_udf", "test_db", "test_schema")
+	r.Equal(u.Describe(), `DESCRIBE FUNCTION "test_db"."test_schema"."test_udf"()`)
+
+	u.WithArgumentTypes([]string{"NUMBER", "VARCHAR"})
+	r.Equal(u.Describe(), `DESCRIBE FUNCTION "test_db"."test_schema"."test_udf"(NUMBER, VARCHAR)`)
+
+	u.WithArgumentTypes([]string{"NUMBER(38,0)", "VARCHAR(100)"})
+	r.Equal(u.Describe(), `DESCRIBE FUNCTION "test_db"."test_schema"."test_udf"(NUMBER(38,0), VARCHAR(100))`)
+}
+
+func TestUdfArgumentTypes(t *testing.T) {
+	r := require.New(t)
+
+	u := &UdfStruct{}
+	u.Arguments.String = "TEST_UDF(NUMBER, VARCHAR) RETURN VARCHAR"
+	r.Equal([]string{"NUMBER", "VARCHAR"}, u.ArgumentTypes())
+
+	u.Arguments.String = "TEST_UDF() RETURN TABLE (A NUMBER)"
+	r.Equal([]string{}, u.ArgumentTypes())
+
+	u.Arguments.String = "TEST_UDF(NUMBER(38,0), VARCHAR) RETURN NUMBER(38,0)"
+	r.Equal([]string{"NUMBER(38,0)", "VARCHAR"}, u.ArgumentTypes())
+
+	u.Arguments.String = "TEST_UDF(VECTOR(FLOAT, 256)) RETURN TABLE (A NUMBER, B VARCHAR)"
+	r.Equal([]string{"VECTOR(FLOAT, 256)"}, u.ArgumentTypes())
+
+	u.Arguments.String = "TEST_UDF(ARRAY, VECTOR(INT, 8), OBJECT) RETURN VARIANT"
+	r.Equal([]string{"ARRAY", "VECTOR(INT, 8)", "OBJECT"}, u.ArgumentTypes())
+
+	u.Name.String = "f(x"
+	u.Arguments.String = "f(x(NUMBER, VARCHAR) RETURN NUMBER"
+	r.Equal([]string{"NUMBER", "VARCHAR"}, u.ArgumentTypes())
+
+	u.Name.String = "f(x"
+	u.Arguments.String = "f(x() RETURN NUMBER"
+	r.Equal([]string{}, u.ArgumentTypes())
+}
+
+func TestScanUdfDescription(t *testing.T) {
+	r := require.New(t)
+	mockDB, mock, err := sqlmock.New()
+	r.NoError(err)
+	defer mockDB.Close()
+	sqlxDB := sqlx.NewDb(mockDB, "sqlmock")
+
+	rows := sqlmock.NewRows([]string{"property", "value"}).
+		AddRow("signature", "(A NUMBER)").
+		AddRow("returns", "NUMBER(38,0)").
+		AddRow("language", "SQL").
+		AddRow("body", nil)
+	mock.ExpectQuery(`DESCRIBE FUNCTION`).WillReturnRows(rows)
+
+	res, err := sqlxDB.Queryx(`DESCRIBE FUNCTION "test_db"."test_schema"."test_udf"(NUMBER)`)
+	r.NoError(err)
+	desc, err := ScanUdfDescription(res)
+	r.NoError(err)
+	r.Equal("(A NUMBER)", desc.Signature.String)
+	r.Equal("NUMBER(38,0)", desc.Returns.String)
+	r.False(desc.Body.Valid)
+}
+
+func TestParseSignature(t *testing.T) {
+	tests := []struct {
+		name      string
+		signature string
+		want      Arguments
+		wantErr   bool
+	}{
+		{"empty", "()", Arguments{}, false},
+		{"empty with spaces", " ( ) ", Arguments{}, false},
+		{"single", "(A NUMBER)", Arguments{{Name: "A", Type: "NUMBER"}}, false},
+		{"multiple", "(A NUMBER, B VARCHAR)", Arguments{{Name: "A", Type: "NUMBER"}, {Name: "B", Type: "VARCHAR"}}, false},
+		{"parameterized", "(A NUMBER(10,2), B VECTOR(FLOAT, 256))", Arguments{{Name: "A", Type: "NUMBER(10,2)"}, {Name: "B", Type: "VECTOR(FLOAT, 256)"}}, false},
+		{"quoted names", `("my arg" NUMBER, "a,""b""(" VARCHAR)`, Arguments{{Name: "my arg", Type: "NUMBER"}, {Name: `a,"b"(`, Type: "VARCHAR"}}, false},
+		{"defaults", `(A NUMBER DEFAULT 10, B VARCHAR default 'x, (y)')`, Arguments{{Name: "A", Type: "NUMBER", Default: "10"}, {Name: "B", Type: "VARCHAR", Default: "'x, (y)'"}}, false},
+		{"table", "TABLE (COL1 NUMBER, COL2 VARCHAR(100))", Arguments{{Name: "COL1", Type: "NUMBER"}, {Name: "COL2", Type: "VARCHAR(100)"}}, false},
+		{"table no space", "table(COL1 NUMBER)", Arguments{{Name: "COL1", Type: "NUMBER"}}, false},
+		{"empty string", "", nil, true},
+		{"no parens", "A NUMBER", nil, true},
+		{"missing type", "(A)", nil, true},
+		{"empty argument", "(A NUMBER,, B VARCHAR)", nil, true},
+		{"unbalanced", "(A NUMBER(38, B VARCHAR)", nil, true},
+		{"two groups", "(A NUMBER) (B VARCHAR)", nil, true},
+		{"unterminated quote", `("A NUMBER)`, nil, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := require.New(t)
+			got, err := ParseSignature(tt.signature)
+			if tt.wantErr {
+				r.Error(err)
+				return
+			}
+			r.NoError(err)
+			r.Equal(tt.want, got)
+		})
+	}
+}